@@ -0,0 +1,130 @@
+// Copyright 2019-2023 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtt
+
+import "sync/atomic"
+
+// RoutingIndicator tells Translate whether the rewritten PartyAddress should
+// be routed on Global Title or on Point Code/Subsystem Number.
+type RoutingIndicator int
+
+const (
+	// RouteUnspecified leaves the address's existing routing indicator bit
+	// untouched.
+	RouteUnspecified RoutingIndicator = iota
+	// RouteOnGT routes the translated address on Global Title.
+	RouteOnGT
+	// RouteOnSSN routes the translated address on Point Code/SSN.
+	RouteOnSSN
+)
+
+// LoadShareMode selects how Translate picks one Result out of several
+// configured on the same Rule.
+type LoadShareMode int
+
+const (
+	// LoadShareNone always returns the first Result.
+	LoadShareNone LoadShareMode = iota
+	// LoadShareRoundRobin cycles through Results on successive lookups.
+	LoadShareRoundRobin
+	// LoadShareHash picks a Result deterministically from the address's
+	// digits as decoded before translation.
+	LoadShareHash
+)
+
+// Result is one possible outcome of a GTT lookup: the rewritten address
+// fields plus the routing indicator to apply. A nil SubsystemNumber/
+// PointCode leaves that field of the address untouched, rather than
+// zeroing it; a zero-value value must be set explicitly via a pointer to
+// take effect, exactly so that "not specified" and "explicitly 0" can be
+// told apart.
+type Result struct {
+	// TranslatedGT, if non-empty, replaces the address's GlobalTitleInfo
+	// and recomputes its odd/even EncodingScheme.
+	TranslatedGT string
+
+	SubsystemNumber *uint8
+	PointCode       *uint32
+
+	// RoutingIndicator selects whether the translated address routes on GT
+	// or on PC/SSN. RouteUnspecified (the zero value) leaves the address's
+	// existing routing bit as-is.
+	RoutingIndicator RoutingIndicator
+}
+
+// Rule is a single GTT entry: a key matched against an incoming
+// PartyAddress, and one or more candidate Results to route matching traffic
+// to. A zero value for GTI, TranslationType, NumberingPlan or
+// NatureOfAddressIndicator means "don't care" for that field.
+type Rule struct {
+	GTI                      int
+	TranslationType          uint8
+	NumberingPlan            int
+	NatureOfAddressIndicator uint8
+
+	// Prefix is the leading digits (in the conventional, non-swapped digit
+	// order returned by PartyAddress.Digits) that an address must match.
+	// Rules are evaluated longest-Prefix-first.
+	Prefix string
+
+	LoadShare LoadShareMode
+	Results   []Result
+
+	rrCounter uint64
+}
+
+// matches reports whether r's key fields accept the given GTI/TT/NP/NAI and
+// digit string.
+func (r *Rule) matches(gti int, tt uint8, np int, nai uint8, digits string) bool {
+	if r.GTI != 0 && r.GTI != gti {
+		return false
+	}
+	if r.TranslationType != 0 && r.TranslationType != tt {
+		return false
+	}
+	if r.NumberingPlan != 0 && r.NumberingPlan != np {
+		return false
+	}
+	if r.NatureOfAddressIndicator != 0 && r.NatureOfAddressIndicator != nai {
+		return false
+	}
+	return len(digits) >= len(r.Prefix) && digits[:len(r.Prefix)] == r.Prefix
+}
+
+// pick selects one Result according to r's LoadShareMode. It returns nil if
+// r has no Results at all.
+func (r *Rule) pick(digits string) *Result {
+	switch len(r.Results) {
+	case 0:
+		return nil
+	case 1:
+		return &r.Results[0]
+	}
+
+	switch r.LoadShare {
+	case LoadShareRoundRobin:
+		n := atomic.AddUint64(&r.rrCounter, 1) - 1
+		return &r.Results[n%uint64(len(r.Results))]
+	case LoadShareHash:
+		return &r.Results[hashDigits(digits)%uint32(len(r.Results))]
+	default:
+		return &r.Results[0]
+	}
+}
+
+// hashDigits is a small FNV-1a hash, good enough to spread looked-up
+// digits evenly across a Rule's Results.
+func hashDigits(digits string) uint32 {
+	const (
+		offset32 = 2166136261
+		prime32  = 16777619
+	)
+	h := uint32(offset32)
+	for i := 0; i < len(digits); i++ {
+		h ^= uint32(digits[i])
+		h *= prime32
+	}
+	return h
+}
@@ -0,0 +1,105 @@
+// Copyright 2019-2023 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+// Package gtt implements Global Title Translation (GTT): the routing lookup
+// an SCCP relay point performs to decide where a Called Party Address
+// should actually be delivered, rewriting it in the process.
+package gtt
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/wmnsk/go-sccp/params"
+)
+
+// ErrNoRoute is returned by Table.Translate when no Rule matches an address
+// and the Table has no Default Result configured.
+var ErrNoRoute = errors.New("gtt: no matching rule and no default route")
+
+// Table is a Global Title Translation table: a set of Rules, matched
+// longest-prefix-first, plus an optional Default route used when nothing
+// else matches.
+type Table struct {
+	rules   []*Rule
+	Default *Result
+}
+
+// New creates an empty Table ready to have Rules added to it.
+func New() *Table {
+	return &Table{}
+}
+
+// AddRule registers r in t. Rules are kept sorted by Prefix length so that
+// Translate always tries the most specific Rule first, regardless of the
+// order AddRule was called in.
+func (t *Table) AddRule(r *Rule) {
+	t.rules = append(t.rules, r)
+	sort.SliceStable(t.rules, func(i, j int) bool {
+		return len(t.rules[i].Prefix) > len(t.rules[j].Prefix)
+	})
+}
+
+// Translate performs a GTT lookup for addr and returns a new PartyAddress
+// rewritten according to the longest-prefix-matching Rule (or Table.Default
+// when nothing matches). addr itself is left untouched.
+func (t *Table) Translate(addr *params.PartyAddress) (*params.PartyAddress, error) {
+	digits, err := addr.Digits()
+	if err != nil {
+		return nil, fmt.Errorf("gtt: decoding GlobalTitleInfo: %w", err)
+	}
+
+	result := t.lookup(addr, digits)
+	if result == nil {
+		return nil, ErrNoRoute
+	}
+
+	out := *addr
+	if result.TranslatedGT != "" {
+		info, odd, err := params.EncodeDigits(result.TranslatedGT)
+		if err != nil {
+			return nil, fmt.Errorf("gtt: translated GT %q: %w", result.TranslatedGT, err)
+		}
+		out.GlobalTitleInfo = info
+		if odd {
+			out.EncodingScheme = int(params.ESBCDOdd)
+		} else {
+			out.EncodingScheme = int(params.ESBCDEven)
+		}
+	}
+	if result.SubsystemNumber != nil {
+		out.SubsystemNumber = *result.SubsystemNumber
+		out.Indicator |= 0x1 << 1 // HasSSN
+	}
+	if result.PointCode != nil {
+		out.SignalingPointCode = *result.PointCode
+		out.Indicator |= 0x1 // HasPC
+	}
+
+	const routeOnSSNBit = 1 << 6
+	switch result.RoutingIndicator {
+	case RouteOnGT:
+		out.Indicator &^= routeOnSSNBit
+	case RouteOnSSN:
+		out.Indicator |= routeOnSSNBit
+	}
+	out.SetLength()
+
+	return &out, nil
+}
+
+// lookup finds the Result for addr's decoded digits, falling back to
+// t.Default. It returns nil when there is no match and no default.
+func (t *Table) lookup(addr *params.PartyAddress, digits string) *Result {
+	for _, r := range t.rules {
+		if !r.matches(addr.GTI(), addr.TranslationType, addr.NumberingPlan, addr.NatureOfAddressIndicator, digits) {
+			continue
+		}
+		if result := r.pick(digits); result != nil {
+			return result
+		}
+	}
+	return t.Default
+}
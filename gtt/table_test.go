@@ -0,0 +1,188 @@
+// Copyright 2019-2023 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtt
+
+import (
+	"testing"
+
+	"github.com/wmnsk/go-sccp/params"
+)
+
+func addrWithDigits(t *testing.T, digits string) *params.PartyAddress {
+	t.Helper()
+	p, err := params.NewAddressBuilder().
+		RouteOnGT().
+		WithPC(42).
+		WithSSN(8).
+		WithGTI4(0, params.NPISDNTelephony, params.ESBCDOdd, params.NAIInternationalNumber).
+		Digits(digits).
+		Build()
+	if err != nil {
+		t.Fatalf("building test address: %v", err)
+	}
+	return p
+}
+
+func uint8p(v uint8) *uint8    { return &v }
+func uint32p(v uint32) *uint32 { return &v }
+
+func TestTranslateLongestPrefixWins(t *testing.T) {
+	table := New()
+	table.AddRule(&Rule{Prefix: "44", Results: []Result{{PointCode: uint32p(1)}}})
+	table.AddRule(&Rule{Prefix: "4412", Results: []Result{{PointCode: uint32p(2)}}})
+
+	out, err := table.Translate(addrWithDigits(t, "441234"))
+	if err != nil {
+		t.Fatalf("Translate: %v", err)
+	}
+	if out.SignalingPointCode != 2 {
+		t.Errorf("SignalingPointCode = %d, want 2 (longest-prefix rule should win)", out.SignalingPointCode)
+	}
+}
+
+func TestTranslatePreservesUnsetFields(t *testing.T) {
+	table := New()
+	table.AddRule(&Rule{Prefix: "441", Results: []Result{{TranslatedGT: "441234567890"}}})
+
+	in := addrWithDigits(t, "441234")
+	out, err := table.Translate(in)
+	if err != nil {
+		t.Fatalf("Translate: %v", err)
+	}
+	if out.SignalingPointCode != in.SignalingPointCode {
+		t.Errorf("SignalingPointCode = %d, want unchanged %d", out.SignalingPointCode, in.SignalingPointCode)
+	}
+	if out.SubsystemNumber != in.SubsystemNumber {
+		t.Errorf("SubsystemNumber = %d, want unchanged %d", out.SubsystemNumber, in.SubsystemNumber)
+	}
+	if !out.HasPC() || !out.HasSSN() {
+		t.Errorf("HasPC/HasSSN = %v/%v, want both true (unchanged)", out.HasPC(), out.HasSSN())
+	}
+}
+
+func TestTranslateSetsOnlyConfiguredFields(t *testing.T) {
+	table := New()
+	table.AddRule(&Rule{Prefix: "441", Results: []Result{{SubsystemNumber: uint8p(99)}}})
+
+	out, err := table.Translate(addrWithDigits(t, "441234"))
+	if err != nil {
+		t.Fatalf("Translate: %v", err)
+	}
+	if out.SubsystemNumber != 99 {
+		t.Errorf("SubsystemNumber = %d, want 99", out.SubsystemNumber)
+	}
+	if out.SignalingPointCode != 42 {
+		t.Errorf("SignalingPointCode = %d, want unchanged 42", out.SignalingPointCode)
+	}
+}
+
+func TestTranslateRecomputesEncodingSchemeParity(t *testing.T) {
+	table := New()
+	table.AddRule(&Rule{Prefix: "1234", Results: []Result{{TranslatedGT: "12345"}}})
+
+	in := addrWithDigits(t, "1234")
+	out, err := table.Translate(in)
+	if err != nil {
+		t.Fatalf("Translate: %v", err)
+	}
+	got, err := out.Digits()
+	if err != nil {
+		t.Fatalf("Digits() on translated address: %v", err)
+	}
+	if got != "12345" {
+		t.Errorf("Digits() = %q, want %q", got, "12345")
+	}
+}
+
+func TestTranslateRejectsInvalidTranslatedGT(t *testing.T) {
+	table := New()
+	table.AddRule(&Rule{Prefix: "441", Results: []Result{{TranslatedGT: "12x4"}}})
+
+	if _, err := table.Translate(addrWithDigits(t, "441234")); err == nil {
+		t.Error("Translate with a non-TBCD TranslatedGT should return an error")
+	}
+}
+
+func TestTranslateDefaultRoute(t *testing.T) {
+	table := New()
+	table.Default = &Result{PointCode: uint32p(7)}
+
+	out, err := table.Translate(addrWithDigits(t, "999"))
+	if err != nil {
+		t.Fatalf("Translate: %v", err)
+	}
+	if out.SignalingPointCode != 7 {
+		t.Errorf("SignalingPointCode = %d, want 7 (default route)", out.SignalingPointCode)
+	}
+}
+
+func TestTranslateNoRoute(t *testing.T) {
+	table := New()
+	if _, err := table.Translate(addrWithDigits(t, "999")); err != ErrNoRoute {
+		t.Errorf("Translate with no matching rule/default = %v, want ErrNoRoute", err)
+	}
+}
+
+func TestTranslateRejectsMalformedGlobalTitleInfo(t *testing.T) {
+	table := New()
+	table.AddRule(&Rule{Prefix: "44", Results: []Result{{PointCode: uint32p(1)}}})
+
+	in := addrWithDigits(t, "441234")
+	in.GlobalTitleInfo = []byte{0xf1, 0xf2} // invalid TBCD nibbles
+
+	if _, err := table.Translate(in); err == nil {
+		t.Error("Translate with malformed GlobalTitleInfo should return an error, not panic")
+	}
+}
+
+func TestRuleLoadShareRoundRobin(t *testing.T) {
+	r := &Rule{
+		Prefix:    "44",
+		LoadShare: LoadShareRoundRobin,
+		Results:   []Result{{PointCode: uint32p(1)}, {PointCode: uint32p(2)}},
+	}
+	table := New()
+	table.AddRule(r)
+
+	var got []uint32
+	for i := 0; i < 4; i++ {
+		out, err := table.Translate(addrWithDigits(t, "441234"))
+		if err != nil {
+			t.Fatalf("Translate: %v", err)
+		}
+		got = append(got, out.SignalingPointCode)
+	}
+	want := []uint32{1, 2, 1, 2}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("round %d: SignalingPointCode = %d, want %d (sequence %v)", i, got[i], want[i], got)
+			break
+		}
+	}
+}
+
+func TestRuleLoadShareHashIsDeterministic(t *testing.T) {
+	r := &Rule{
+		Prefix:    "44",
+		LoadShare: LoadShareHash,
+		Results:   []Result{{PointCode: uint32p(1)}, {PointCode: uint32p(2)}, {PointCode: uint32p(3)}},
+	}
+	table := New()
+	table.AddRule(r)
+
+	first, err := table.Translate(addrWithDigits(t, "441234"))
+	if err != nil {
+		t.Fatalf("Translate: %v", err)
+	}
+	for i := 0; i < 5; i++ {
+		out, err := table.Translate(addrWithDigits(t, "441234"))
+		if err != nil {
+			t.Fatalf("Translate: %v", err)
+		}
+		if out.SignalingPointCode != first.SignalingPointCode {
+			t.Errorf("LoadShareHash picked %d then %d for the same digits", first.SignalingPointCode, out.SignalingPointCode)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2019-2023 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package params
+
+import (
+	"errors"
+	"fmt"
+)
+
+// AddressBuilder builds a PartyAddress field by field, so that callers no
+// longer have to remember the positional, untyped argument order of
+// NewPartyAddress or hand-pack GlobalTitleInfo themselves. Obtain one with
+// NewAddressBuilder, chain the With*/RouteOn* methods that apply to the
+// address being built, then call Build.
+//
+// A GTI-selecting method (WithGTI1..WithGTI4) must be called exactly once;
+// Build reports an error otherwise.
+type AddressBuilder struct {
+	p         PartyAddress
+	gtiCalls  int
+	digitsErr error
+}
+
+// NewAddressBuilder returns an empty AddressBuilder.
+func NewAddressBuilder() *AddressBuilder {
+	return &AddressBuilder{}
+}
+
+// RouteOnGT marks the address as routed on Global Title.
+func (b *AddressBuilder) RouteOnGT() *AddressBuilder {
+	b.p.Indicator &^= 1 << 6
+	return b
+}
+
+// RouteOnSSN marks the address as routed on Point Code/Subsystem Number.
+func (b *AddressBuilder) RouteOnSSN() *AddressBuilder {
+	b.p.Indicator |= 1 << 6
+	return b
+}
+
+// WithPC sets the Signaling Point Code and marks it as present.
+func (b *AddressBuilder) WithPC(pc int) *AddressBuilder {
+	b.p.SignalingPointCode = uint32(pc)
+	b.p.Indicator |= 0x1
+	return b
+}
+
+// WithSSN sets the Subsystem Number and marks it as present.
+func (b *AddressBuilder) WithSSN(ssn int) *AddressBuilder {
+	b.p.SubsystemNumber = uint8(ssn)
+	b.p.Indicator |= 0x1 << 1
+	return b
+}
+
+// WithGTI1 selects GlobalTitleIndicator 1 (Nature of Address Indicator
+// only).
+func (b *AddressBuilder) WithGTI1(nai NatureOfAddress) *AddressBuilder {
+	b.setGTI(GTINatureOfAddressOnly)
+	b.p.NatureOfAddressIndicator = uint8(nai)
+	return b
+}
+
+// WithGTI2 selects GlobalTitleIndicator 2 (Translation Type only).
+func (b *AddressBuilder) WithGTI2(tt uint8) *AddressBuilder {
+	b.setGTI(GTITranslationTypeOnly)
+	b.p.TranslationType = tt
+	return b
+}
+
+// WithGTI3 selects GlobalTitleIndicator 3 (Translation Type, Numbering
+// Plan and Encoding Scheme).
+func (b *AddressBuilder) WithGTI3(tt uint8, np NumberingPlan, es EncodingScheme) *AddressBuilder {
+	b.setGTI(GTITranslationNumberingEncoding)
+	b.p.TranslationType = tt
+	b.p.NumberingPlan = int(np)
+	b.p.EncodingScheme = int(es)
+	return b
+}
+
+// WithGTI4 selects GlobalTitleIndicator 4 (Translation Type, Numbering
+// Plan, Encoding Scheme and Nature of Address Indicator).
+func (b *AddressBuilder) WithGTI4(tt uint8, np NumberingPlan, es EncodingScheme, nai NatureOfAddress) *AddressBuilder {
+	b.setGTI(GTITranslationNumberingEncodingNA)
+	b.p.TranslationType = tt
+	b.p.NumberingPlan = int(np)
+	b.p.EncodingScheme = int(es)
+	b.p.NatureOfAddressIndicator = uint8(nai)
+	return b
+}
+
+func (b *AddressBuilder) setGTI(gti GlobalTitleIndicator) {
+	b.p.Indicator = b.p.Indicator&^(0xf<<2) | uint8(gti)<<2
+	b.gtiCalls++
+}
+
+// Digits sets the GlobalTitle's digits. digits is TBCD-encoded into
+// GlobalTitleInfo, swapping nibbles the way the wire format requires and
+// choosing odd or even EncodingScheme automatically depending on whether
+// len(digits) is odd or even. It encodes digits the same way SetDigits
+// does; a malformed digit string makes Build return the error.
+func (b *AddressBuilder) Digits(digits string) *AddressBuilder {
+	info, odd, err := encodeTBCD(digits)
+	if err != nil {
+		b.digitsErr = err
+		return b
+	}
+	b.p.GlobalTitleInfo = info
+	if odd {
+		b.p.EncodingScheme = int(ESBCDOdd)
+	} else {
+		b.p.EncodingScheme = int(ESBCDEven)
+	}
+	return b
+}
+
+// Build validates the accumulated fields and returns the resulting
+// PartyAddress.
+func (b *AddressBuilder) Build() (*PartyAddress, error) {
+	if b.digitsErr != nil {
+		return nil, b.digitsErr
+	}
+	if b.gtiCalls == 0 {
+		return nil, errors.New("sccp: AddressBuilder: no WithGTIx call, GlobalTitleIndicator not set")
+	}
+	if b.gtiCalls > 1 {
+		return nil, fmt.Errorf("sccp: AddressBuilder: WithGTIx called %d times, want exactly once", b.gtiCalls)
+	}
+	if !b.p.HasPC() && !b.p.HasSSN() {
+		return nil, errors.New("sccp: AddressBuilder: neither PC nor SSN set, address would not be routable")
+	}
+
+	p := b.p
+	p.SetLength()
+	return &p, nil
+}
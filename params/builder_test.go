@@ -0,0 +1,70 @@
+// Copyright 2019-2023 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package params
+
+import "testing"
+
+func TestAddressBuilderBuild(t *testing.T) {
+	p, err := NewAddressBuilder().
+		RouteOnGT().
+		WithPC(42).
+		WithSSN(8).
+		WithGTI4(1, NPISDNTelephony, ESBCDOdd, NAIInternationalNumber).
+		Digits("441234").
+		Build()
+	if err != nil {
+		t.Fatalf("Build(): %v", err)
+	}
+	if !p.RouteOnGT() {
+		t.Error("RouteOnGT() = false, want true")
+	}
+	if !p.HasPC() || p.SignalingPointCode != 42 {
+		t.Errorf("HasPC/SignalingPointCode = %v/%d, want true/42", p.HasPC(), p.SignalingPointCode)
+	}
+	if !p.HasSSN() || p.SubsystemNumber != 8 {
+		t.Errorf("HasSSN/SubsystemNumber = %v/%d, want true/8", p.HasSSN(), p.SubsystemNumber)
+	}
+	if p.GTI() != int(GTITranslationNumberingEncodingNA) {
+		t.Errorf("GTI() = %d, want %d", p.GTI(), GTITranslationNumberingEncodingNA)
+	}
+}
+
+func TestAddressBuilderRouteOnSSN(t *testing.T) {
+	p, err := NewAddressBuilder().RouteOnSSN().WithSSN(8).WithGTI2(5).Build()
+	if err != nil {
+		t.Fatalf("Build(): %v", err)
+	}
+	if p.RouteOnGT() {
+		t.Error("RouteOnGT() = true, want false after RouteOnSSN()")
+	}
+}
+
+func TestAddressBuilderNoGTI(t *testing.T) {
+	_, err := NewAddressBuilder().WithPC(1).Build()
+	if err == nil {
+		t.Error("Build() with no WithGTIx call should return an error")
+	}
+}
+
+func TestAddressBuilderMultipleGTICalls(t *testing.T) {
+	_, err := NewAddressBuilder().WithPC(1).WithGTI2(5).WithGTI4(1, NPISDNTelephony, ESBCDOdd, NAIInternationalNumber).Build()
+	if err == nil {
+		t.Error("Build() after two WithGTIx calls should return an error, not silently keep the last one")
+	}
+}
+
+func TestAddressBuilderNoPCOrSSN(t *testing.T) {
+	_, err := NewAddressBuilder().WithGTI1(NAIInternationalNumber).Build()
+	if err == nil {
+		t.Error("Build() with neither PC nor SSN set should return an error")
+	}
+}
+
+func TestAddressBuilderBadDigits(t *testing.T) {
+	_, err := NewAddressBuilder().WithPC(1).WithGTI2(5).Digits("12x4").Build()
+	if err == nil {
+		t.Error("Build() with a non-TBCD digit string should return an error")
+	}
+}
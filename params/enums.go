@@ -0,0 +1,145 @@
+// Copyright 2019-2023 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package params
+
+import "strconv"
+
+// GlobalTitleIndicator selects which GlobalTitle fields are present in a
+// PartyAddress, per ITU-T Q.713 3.4.2.2.
+type GlobalTitleIndicator int
+
+// GlobalTitleIndicator values defined in ITU-T Q.713.
+const (
+	GTINoGlobalTitle                  GlobalTitleIndicator = 0x0
+	GTINatureOfAddressOnly            GlobalTitleIndicator = 0x1
+	GTITranslationTypeOnly            GlobalTitleIndicator = 0x2
+	GTITranslationNumberingEncoding   GlobalTitleIndicator = 0x3
+	GTITranslationNumberingEncodingNA GlobalTitleIndicator = 0x4
+)
+
+// String implements the fmt.Stringer interface.
+func (gti GlobalTitleIndicator) String() string {
+	switch gti {
+	case GTINoGlobalTitle:
+		return "NoGlobalTitle"
+	case GTINatureOfAddressOnly:
+		return "NatureOfAddressOnly"
+	case GTITranslationTypeOnly:
+		return "TranslationTypeOnly"
+	case GTITranslationNumberingEncoding:
+		return "TranslationNumberingEncoding"
+	case GTITranslationNumberingEncodingNA:
+		return "TranslationNumberingEncodingNatureOfAddress"
+	default:
+		return "Unknown(" + strconv.Itoa(int(gti)) + ")"
+	}
+}
+
+// NumberingPlan identifies the numbering plan used by a GlobalTitle, carried
+// in the 4 most significant bits of the Numbering Plan/Encoding Scheme
+// octet (ITU-T Q.713 3.4.2.3.1).
+type NumberingPlan int
+
+// NumberingPlan values defined in ITU-T Q.713.
+const (
+	NPUnknown        NumberingPlan = 0x0
+	NPISDNTelephony  NumberingPlan = 0x1
+	NPGeneric        NumberingPlan = 0x2
+	NPData           NumberingPlan = 0x3
+	NPTelex          NumberingPlan = 0x4
+	NPMaritimeMobile NumberingPlan = 0x5
+	NPLandMobile     NumberingPlan = 0x6
+	NPISDNMobile     NumberingPlan = 0x7
+	NPPrivate        NumberingPlan = 0xe
+)
+
+// String implements the fmt.Stringer interface.
+func (np NumberingPlan) String() string {
+	switch np {
+	case NPUnknown:
+		return "Unknown"
+	case NPISDNTelephony:
+		return "ISDN/Telephony"
+	case NPGeneric:
+		return "Generic"
+	case NPData:
+		return "Data"
+	case NPTelex:
+		return "Telex"
+	case NPMaritimeMobile:
+		return "MaritimeMobile"
+	case NPLandMobile:
+		return "LandMobile"
+	case NPISDNMobile:
+		return "ISDN/Mobile"
+	case NPPrivate:
+		return "Private"
+	default:
+		return "Reserved(" + strconv.Itoa(int(np)) + ")"
+	}
+}
+
+// EncodingScheme identifies how the digits in GlobalTitleInfo are encoded,
+// carried in the 4 least significant bits of the Numbering Plan/Encoding
+// Scheme octet (ITU-T Q.713 3.4.2.3.2).
+type EncodingScheme int
+
+// EncodingScheme values defined in ITU-T Q.713.
+const (
+	ESUnknown  EncodingScheme = 0x0
+	ESBCDOdd   EncodingScheme = 0x1
+	ESBCDEven  EncodingScheme = 0x2
+	ESNational EncodingScheme = 0x3
+	ESReserved EncodingScheme = 0x4
+)
+
+// String implements the fmt.Stringer interface.
+func (es EncodingScheme) String() string {
+	switch es {
+	case ESUnknown:
+		return "Unknown"
+	case ESBCDOdd:
+		return "BCDOdd"
+	case ESBCDEven:
+		return "BCDEven"
+	case ESNational:
+		return "NationalSpecific"
+	case ESReserved:
+		return "Reserved"
+	default:
+		return "Reserved(" + strconv.Itoa(int(es)) + ")"
+	}
+}
+
+// NatureOfAddress identifies the Nature of Address Indicator carried
+// alongside a GlobalTitle (ITU-T Q.713 3.4.2.3.3).
+type NatureOfAddress uint8
+
+// NatureOfAddress values defined in ITU-T Q.713.
+const (
+	NAIUnknown                NatureOfAddress = 0x00
+	NAISubscriberNumber       NatureOfAddress = 0x01
+	NAIReservedForNational    NatureOfAddress = 0x02
+	NAINationalSignificantNum NatureOfAddress = 0x03
+	NAIInternationalNumber    NatureOfAddress = 0x04
+)
+
+// String implements the fmt.Stringer interface.
+func (nai NatureOfAddress) String() string {
+	switch nai {
+	case NAIUnknown:
+		return "Unknown"
+	case NAISubscriberNumber:
+		return "SubscriberNumber"
+	case NAIReservedForNational:
+		return "ReservedForNational"
+	case NAINationalSignificantNum:
+		return "NationalSignificantNumber"
+	case NAIInternationalNumber:
+		return "InternationalNumber"
+	default:
+		return "Spare(" + strconv.Itoa(int(nai)) + ")"
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2019-2023 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package params
+
+import "testing"
+
+func TestGlobalTitleIndicatorString(t *testing.T) {
+	if got := GTITranslationNumberingEncodingNA.String(); got != "TranslationNumberingEncodingNatureOfAddress" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := GlobalTitleIndicator(9).String(); got != "Unknown(9)" {
+		t.Errorf("String() for out-of-range value = %q, want %q", got, "Unknown(9)")
+	}
+}
+
+func TestNumberingPlanString(t *testing.T) {
+	if got := NPISDNTelephony.String(); got != "ISDN/Telephony" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := NumberingPlan(9).String(); got != "Reserved(9)" {
+		t.Errorf("String() for out-of-range value = %q, want %q", got, "Reserved(9)")
+	}
+}
+
+func TestEncodingSchemeString(t *testing.T) {
+	if got := ESBCDOdd.String(); got != "BCDOdd" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := EncodingScheme(9).String(); got != "Reserved(9)" {
+		t.Errorf("String() for out-of-range value = %q, want %q", got, "Reserved(9)")
+	}
+}
+
+func TestNatureOfAddressString(t *testing.T) {
+	if got := NAIInternationalNumber.String(); got != "InternationalNumber" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := NatureOfAddress(9).String(); got != "Spare(9)" {
+		t.Errorf("String() for out-of-range value = %q, want %q", got, "Spare(9)")
+	}
+}
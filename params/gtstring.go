@@ -0,0 +1,148 @@
+// Copyright 2019-2023 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package params
+
+import (
+	"errors"
+	"fmt"
+)
+
+// DigitCodec encodes and decodes GlobalTitleInfo for a non-standard
+// EncodingScheme (e.g. IA5 or UTF-8). Register one with RegisterCodec.
+// Digits dispatches to a registered codec's Decode; SetDigits always
+// TBCD-encodes and does not call Encode.
+type DigitCodec interface {
+	Encode(digits string) (info []byte, odd bool, err error)
+	Decode(info []byte, odd bool) (string, error)
+}
+
+// codecs holds the DigitCodec registered for each non-BCD EncodingScheme.
+// ESBCDOdd and ESBCDEven are handled internally and cannot be overridden.
+var codecs = map[EncodingScheme]DigitCodec{}
+
+// RegisterCodec registers c to handle Digits for es. It panics if
+// es is ESBCDOdd or ESBCDEven, which are reserved for the built-in TBCD
+// codec.
+func RegisterCodec(es EncodingScheme, c DigitCodec) {
+	if es == ESBCDOdd || es == ESBCDEven {
+		panic("sccp: RegisterCodec: " + es.String() + " is reserved for the built-in TBCD codec")
+	}
+	codecs[es] = c
+}
+
+// tbcdAlphabet maps a TBCD-encodable rune to its nibble value, per 3GPP TS
+// 29.002 (digits 0-9, "*", "#" and "a".."c").
+var tbcdAlphabet = map[rune]byte{
+	'0': 0x0, '1': 0x1, '2': 0x2, '3': 0x3, '4': 0x4,
+	'5': 0x5, '6': 0x6, '7': 0x7, '8': 0x8, '9': 0x9,
+	'*': 0xa, '#': 0xb, 'a': 0xc, 'b': 0xd, 'c': 0xe,
+}
+
+// tbcdDigits is the inverse of tbcdAlphabet, indexed by nibble value.
+var tbcdDigits = [...]byte{
+	'0', '1', '2', '3', '4', '5', '6', '7', '8', '9', '*', '#', 'a', 'b', 'c',
+}
+
+const tbcdFiller = 0xf
+
+// encodeTBCD packs digits two-per-byte with the low nibble holding the
+// first digit of each pair (the nibble-swapped order GlobalTitleInfo uses
+// on the wire), padding a trailing odd digit's high nibble with the TBCD
+// filler. It reports whether the result has an odd digit count.
+func encodeTBCD(digits string) ([]byte, bool, error) {
+	if digits == "" {
+		return nil, false, errors.New("sccp: TBCD: empty digit string")
+	}
+
+	b := make([]byte, (len(digits)+1)/2)
+	for i, r := range digits {
+		nibble, ok := tbcdAlphabet[r]
+		if !ok {
+			return nil, false, fmt.Errorf("sccp: TBCD: invalid digit %q in %q", r, digits)
+		}
+		if i%2 == 0 {
+			b[i/2] = nibble
+		} else {
+			b[i/2] |= nibble << 4
+		}
+	}
+
+	odd := len(digits)%2 != 0
+	if odd {
+		b[len(b)-1] |= tbcdFiller << 4
+	}
+	return b, odd, nil
+}
+
+// decodeTBCD is the inverse of encodeTBCD: it unswaps the nibbles of info
+// back into a digit string, dropping the filler nibble of a final odd
+// digit. It rejects a nibble that isn't a valid TBCD digit instead of
+// silently emitting garbage.
+func decodeTBCD(info []byte, odd bool) (string, error) {
+	digits := make([]byte, 0, len(info)*2)
+	for i, o := range info {
+		lo := o & 0xf
+		hi := o >> 4 & 0xf
+		if int(lo) >= len(tbcdDigits) {
+			return "", fmt.Errorf("sccp: TBCD: invalid nibble 0x%x at octet %d", lo, i)
+		}
+		digits = append(digits, tbcdDigits[lo])
+
+		if i == len(info)-1 && odd {
+			break
+		}
+		if int(hi) >= len(tbcdDigits) {
+			return "", fmt.Errorf("sccp: TBCD: invalid nibble 0x%x at octet %d", hi, i)
+		}
+		digits = append(digits, tbcdDigits[hi])
+	}
+	return string(digits), nil
+}
+
+// EncodeDigits TBCD-encodes digits the same way SetDigits does, for callers
+// (e.g. gtt) that need a validated GlobalTitleInfo byte slice without
+// constructing a whole PartyAddress. It reports whether the result has an
+// odd digit count, same as SetDigits picks ESBCDOdd/ESBCDEven from.
+func EncodeDigits(digits string) (info []byte, odd bool, err error) {
+	return encodeTBCD(digits)
+}
+
+// Digits decodes GlobalTitleInfo into a human-readable digit string,
+// honoring the GlobalTitle's EncodingScheme: ESBCDOdd/ESBCDEven are decoded
+// as TBCD, anything else is dispatched to a DigitCodec registered with
+// RegisterCodec. It returns an error rather than panicking or silently
+// truncating on malformed input.
+func (p *PartyAddress) Digits() (string, error) {
+	switch EncodingScheme(p.EncodingScheme) {
+	case ESBCDOdd:
+		return decodeTBCD(p.GlobalTitleInfo, true)
+	case ESBCDEven:
+		return decodeTBCD(p.GlobalTitleInfo, false)
+	default:
+		c, ok := codecs[EncodingScheme(p.EncodingScheme)]
+		if !ok {
+			return "", fmt.Errorf("sccp: Digits: no codec registered for EncodingScheme %s", EncodingScheme(p.EncodingScheme))
+		}
+		return c.Decode(p.GlobalTitleInfo, p.IsOddDigits())
+	}
+}
+
+// SetDigits TBCD-encodes digits into GlobalTitleInfo, choosing ESBCDOdd or
+// ESBCDEven automatically depending on whether len(digits) is odd or even,
+// and rejects characters outside the TBCD alphabet (0-9, "*", "#", "a"-"c")
+// instead of encoding them incorrectly.
+func (p *PartyAddress) SetDigits(digits string) error {
+	info, odd, err := encodeTBCD(digits)
+	if err != nil {
+		return err
+	}
+	p.GlobalTitleInfo = info
+	if odd {
+		p.EncodingScheme = int(ESBCDOdd)
+	} else {
+		p.EncodingScheme = int(ESBCDEven)
+	}
+	return nil
+}
@@ -5,18 +5,25 @@
 package params
 
 import (
-	"encoding/binary"
 	"errors"
 	"io"
-
-	"github.com/wmnsk/go-sccp/utils"
 )
 
 // PartyAddress is a SCCP parameter that represents a Called/Calling Party Address.
 type PartyAddress struct {
-	Length             uint8
-	Indicator          uint8
-	SignalingPointCode uint16
+	Length    uint8
+	Indicator uint8
+	// Variant selects the point-code format SignalingPointCode is encoded
+	// in on the wire; the zero value is ITU14. Each PartyAddress carries
+	// its own Variant so that a single process can interop with multiple
+	// point-code formats at once (e.g. an ITU14 link and an ANSI24 link on
+	// the same STP) without one goroutine's MarshalTo/UnmarshalBinary call
+	// racing another's over shared global state.
+	Variant Variant
+	// SignalingPointCode holds the point code regardless of Variant; only
+	// the low 14, 16 or 24 bits are meaningful, and only Variant's number
+	// of octets is actually put on the wire.
+	SignalingPointCode uint32
 	SubsystemNumber    uint8
 	GlobalTitle
 }
@@ -31,10 +38,14 @@ type GlobalTitle struct {
 }
 
 // NewPartyAddress creates a new PartyAddress including GlobalTitle.
+//
+// The positional int arguments are easy to transpose by mistake; prefer
+// NewAddressBuilder for new code, which validates that the fields set are
+// the ones the chosen GlobalTitleIndicator actually uses.
 func NewPartyAddress(gti, spc, ssn, tt, np, es, nai int, gt []byte) *PartyAddress {
 	p := &PartyAddress{
 		Indicator:          uint8(gti),
-		SignalingPointCode: uint16(spc),
+		SignalingPointCode: uint32(spc),
 		SubsystemNumber:    uint8(ssn),
 		GlobalTitle: GlobalTitle{
 			TranslationType:          uint8(tt),
@@ -63,8 +74,8 @@ func (p *PartyAddress) MarshalTo(b []byte) error {
 	b[1] = p.Indicator
 	var offset = 2
 	if p.HasPC() {
-		binary.BigEndian.PutUint16(b[offset:offset+2], p.SignalingPointCode)
-		offset += 2
+		putPC(b[offset:], p.SignalingPointCode, p.Variant)
+		offset += p.Variant.octets()
 	}
 	if p.HasSSN() {
 		b[offset] = p.SubsystemNumber
@@ -93,9 +104,17 @@ func (p *PartyAddress) MarshalTo(b []byte) error {
 	return nil
 }
 
-// ParsePartyAddress decodes given byte sequence as a SCCP common header.
+// ParsePartyAddress decodes given byte sequence as a SCCP Party Address,
+// assuming ITU14 point codes. Use ParsePartyAddressWithVariant for any
+// other Variant.
 func ParsePartyAddress(b []byte) (*PartyAddress, error) {
-	p := new(PartyAddress)
+	return ParsePartyAddressWithVariant(b, ITU14)
+}
+
+// ParsePartyAddressWithVariant is ParsePartyAddress for a point-code
+// Variant other than ITU14.
+func ParsePartyAddressWithVariant(b []byte, v Variant) (*PartyAddress, error) {
+	p := &PartyAddress{Variant: v}
 	if err := p.UnmarshalBinary(b); err != nil {
 		return nil, err
 	}
@@ -116,43 +135,49 @@ func (p *PartyAddress) UnmarshalBinary(b []byte) error {
 
 	var offset = 2
 	if p.HasPC() {
-		end := offset + 2
-		if end >= len(b) {
+		end := offset + p.Variant.octets()
+		if end > len(b) {
 			return io.ErrUnexpectedEOF
 		}
-		p.SignalingPointCode = binary.BigEndian.Uint16(b[offset:end])
+		p.SignalingPointCode = getPC(b[offset:end], p.Variant)
 		offset = end
 	}
 	if p.HasSSN() {
-		p.SubsystemNumber = b[offset]
-		offset++
 		if offset >= len(b) {
 			return io.ErrUnexpectedEOF
 		}
+		p.SubsystemNumber = b[offset]
+		offset++
 	}
 
 	switch p.GTI() {
 	case 1:
+		if offset >= len(b) {
+			return io.ErrUnexpectedEOF
+		}
 		p.NatureOfAddressIndicator = b[offset]
 		offset++
 	case 2:
+		if offset >= len(b) {
+			return io.ErrUnexpectedEOF
+		}
 		p.TranslationType = b[offset]
 		offset++
 	case 3:
-		p.TranslationType = b[offset]
-		offset++
-		if offset >= len(b) {
+		if offset+1 >= len(b) {
 			return io.ErrUnexpectedEOF
 		}
+		p.TranslationType = b[offset]
+		offset++
 		p.NumberingPlan = int(b[offset]) >> 4 & 0xf
 		p.EncodingScheme = int(b[offset]) & 0xf
 		offset++
 	case 4:
-		p.TranslationType = b[offset]
-		offset++
-		if offset+1 >= len(b) {
+		if offset+2 >= len(b) {
 			return io.ErrUnexpectedEOF
 		}
+		p.TranslationType = b[offset]
+		offset++
 		p.NumberingPlan = int(b[offset]) >> 4 & 0xf
 		p.EncodingScheme = int(b[offset]) & 0xf
 		offset++
@@ -161,11 +186,11 @@ func (p *PartyAddress) UnmarshalBinary(b []byte) error {
 	}
 
 	infoLen := 1 + int(p.Length) - offset
-	if infoLen < 0 {
+	if infoLen < 0 || offset+infoLen > len(b) {
 		return errors.New("sccp: party address length misfit")
 	}
 	p.GlobalTitleInfo = make([]byte, infoLen)
-	copy(p.GlobalTitleInfo, b[offset:])
+	copy(p.GlobalTitleInfo, b[offset:offset+infoLen])
 
 	return nil
 }
@@ -174,7 +199,7 @@ func (p *PartyAddress) UnmarshalBinary(b []byte) error {
 func (p *PartyAddress) MarshalLen() int {
 	l := 2 + len(p.GlobalTitleInfo)
 	if p.HasPC() {
-		l += 2
+		l += p.Variant.octets()
 	}
 	if p.HasSSN() {
 		l++
@@ -197,7 +222,7 @@ func (p *PartyAddress) MarshalLen() int {
 func (p *PartyAddress) SetLength() {
 	l := 1 + len(p.GlobalTitleInfo)
 	if p.HasPC() {
-		l += 2
+		l += p.Variant.octets()
 	}
 	if p.HasSSN() {
 		l++
@@ -240,11 +265,3 @@ func (p *PartyAddress) HasPC() bool {
 func (p *PartyAddress) IsOddDigits() bool {
 	return p.EncodingScheme == 1
 }
-
-// GTString returns the GlobalTitleInfo in human readable string.
-func (p *PartyAddress) GTString() string {
-	if len( p.GlobalTitleInfo > 0 ) {
-		return utils.SwappedBytesToStr(p.GlobalTitleInfo, p.IsOddDigits())
-	}
-	return ""
-}
@@ -0,0 +1,90 @@
+// Copyright 2019-2023 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package params
+
+import "testing"
+
+// FuzzUnmarshalBinary checks that UnmarshalBinary never panics, no matter
+// how the Length/Indicator octets lie about the rest of the buffer.
+func FuzzUnmarshalBinary(f *testing.F) {
+	f.Add([]byte{0x00, 0x00, 0x00})
+	f.Add([]byte{0x03, 0x43, 0x01, 0x02, 0x03})
+	f.Add([]byte{0x01, 0x7f})
+	f.Add([]byte{0xff, 0xff, 0xff, 0xff})
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		p := new(PartyAddress)
+		_ = p.UnmarshalBinary(b)
+	})
+}
+
+// FuzzDigits checks that SetDigits/Digits round-trip any string that
+// SetDigits accepts, and that Digits never panics on a GlobalTitleInfo
+// byte slice of arbitrary length.
+func FuzzDigits(f *testing.F) {
+	f.Add("1234567890")
+	f.Add("441234567890")
+	f.Add("*12#")
+
+	f.Fuzz(func(t *testing.T, digits string) {
+		for _, es := range []EncodingScheme{ESBCDOdd, ESBCDEven} {
+			raw := &PartyAddress{GlobalTitle: GlobalTitle{EncodingScheme: int(es), GlobalTitleInfo: []byte(digits)}}
+			_, _ = raw.Digits()
+		}
+
+		p := new(PartyAddress)
+		if err := p.SetDigits(digits); err != nil {
+			return
+		}
+		got, err := p.Digits()
+		if err != nil {
+			t.Fatalf("Digits() after successful SetDigits(%q) returned error: %v", digits, err)
+		}
+		if got != digits {
+			t.Fatalf("SetDigits(%q) then Digits() = %q", digits, got)
+		}
+	})
+}
+
+func TestUnmarshalBinaryPCOnlyExactLength(t *testing.T) {
+	// Indicator 0x1: HasPC, no SSN, GTI 0 (no GlobalTitle) -> Length/Indicator
+	// plus exactly Variant.octets() (ITU14, the zero value) more bytes for
+	// the PC, nothing else. The point code is the very last data in the
+	// buffer.
+	b := []byte{0x03, 0x01, 0x00, 0x2a}
+	p := new(PartyAddress)
+	if err := p.UnmarshalBinary(b); err != nil {
+		t.Fatalf("UnmarshalBinary(%x): %v", b, err)
+	}
+	if p.SignalingPointCode != 0x002a {
+		t.Errorf("SignalingPointCode = %#x, want %#x", p.SignalingPointCode, 0x002a)
+	}
+}
+
+func TestDigitsRejectsMalformed(t *testing.T) {
+	p := new(PartyAddress)
+	if err := p.SetDigits(""); err == nil {
+		t.Error("SetDigits(\"\") should reject the empty digit string")
+	}
+	if err := p.SetDigits("12x4"); err == nil {
+		t.Error("SetDigits(\"12x4\") should reject a non-TBCD character")
+	}
+}
+
+func TestDigitsRoundTrip(t *testing.T) {
+	for _, digits := range []string{"1234567890", "441234", "*12#"} {
+		p := new(PartyAddress)
+		if err := p.SetDigits(digits); err != nil {
+			t.Fatalf("SetDigits(%q): %v", digits, err)
+		}
+		got, err := p.Digits()
+		if err != nil {
+			t.Fatalf("Digits() after SetDigits(%q): %v", digits, err)
+		}
+		if got != digits {
+			t.Errorf("SetDigits(%q) then Digits() = %q, want %q", digits, got, digits)
+		}
+	}
+}
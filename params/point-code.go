@@ -0,0 +1,134 @@
+// Copyright 2019-2023 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package params
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Variant selects the point-code format a PartyAddress's
+// SignalingPointCode is encoded in: how many octets it occupies on the
+// wire, and how it is conventionally written as text.
+type Variant int
+
+const (
+	// ITU14 is the ITU-T Q.713 14-bit point code, encoded in 2 octets.
+	ITU14 Variant = iota
+	// ANSI24 is the ANSI T1.112 24-bit point code (Network-Cluster-Member,
+	// 8-8-8 bits), encoded in 3 octets.
+	ANSI24
+	// JAPAN16 is the Japanese (TTC/NTT) 16-bit point code, encoded in 2
+	// octets.
+	JAPAN16
+	// CHINA24 is the China (GB) 24-bit point code, encoded in 3 octets.
+	CHINA24
+)
+
+// String implements the fmt.Stringer interface.
+func (v Variant) String() string {
+	switch v {
+	case ITU14:
+		return "ITU14"
+	case ANSI24:
+		return "ANSI24"
+	case JAPAN16:
+		return "JAPAN16"
+	case CHINA24:
+		return "CHINA24"
+	default:
+		return "Unknown(" + strconv.Itoa(int(v)) + ")"
+	}
+}
+
+// octets returns how many octets a point code of this Variant occupies on
+// the wire.
+func (v Variant) octets() int {
+	switch v {
+	case ANSI24, CHINA24:
+		return 3
+	default: // ITU14, JAPAN16
+		return 2
+	}
+}
+
+// putPC writes pc into b using v's octet count, most significant octet
+// first.
+func putPC(b []byte, pc uint32, v Variant) {
+	n := v.octets()
+	for i := 0; i < n; i++ {
+		b[i] = byte(pc >> uint(8*(n-1-i)))
+	}
+}
+
+// getPC reads a point code out of b, which must hold exactly v's octet
+// count, most significant octet first.
+func getPC(b []byte, v Variant) uint32 {
+	var pc uint32
+	for _, o := range b {
+		pc = pc<<8 | uint32(o)
+	}
+	return pc
+}
+
+// FormatPC renders pc in the conventional notation for v: hyphen-separated
+// Network-Cluster-Member for ANSI24/CHINA24, hyphen-separated
+// Zone-(Region*8|SP) for ITU14, and plain decimal for JAPAN16.
+func FormatPC(pc uint32, v Variant) string {
+	switch v {
+	case ANSI24, CHINA24:
+		return fmt.Sprintf("%d-%d-%d", pc>>16&0xff, pc>>8&0xff, pc&0xff)
+	case ITU14:
+		return fmt.Sprintf("%d-%d", pc>>11&0x7, pc&0x7ff)
+	default: // JAPAN16
+		return strconv.FormatUint(uint64(pc), 10)
+	}
+}
+
+// ParsePC parses s, formatted per v's convention (see FormatPC), into a
+// point code value. A plain decimal integer is also accepted for any
+// Variant.
+func ParsePC(s string, v Variant) (uint32, error) {
+	if n, err := strconv.ParseUint(s, 10, 32); err == nil {
+		return uint32(n), nil
+	}
+
+	parts := strings.Split(s, "-")
+	switch v {
+	case ANSI24, CHINA24:
+		if len(parts) != 3 {
+			return 0, fmt.Errorf("sccp: %q is not a valid %s point code, want \"network-cluster-member\"", s, v)
+		}
+		network, err := strconv.ParseUint(parts[0], 10, 8)
+		if err != nil {
+			return 0, fmt.Errorf("sccp: %q is not a valid %s point code: %w", s, v, err)
+		}
+		cluster, err := strconv.ParseUint(parts[1], 10, 8)
+		if err != nil {
+			return 0, fmt.Errorf("sccp: %q is not a valid %s point code: %w", s, v, err)
+		}
+		member, err := strconv.ParseUint(parts[2], 10, 8)
+		if err != nil {
+			return 0, fmt.Errorf("sccp: %q is not a valid %s point code: %w", s, v, err)
+		}
+		return uint32(network)<<16 | uint32(cluster)<<8 | uint32(member), nil
+	case ITU14:
+		if len(parts) != 2 {
+			return 0, fmt.Errorf("sccp: %q is not a valid %s point code, want \"zone-rest\"", s, v)
+		}
+		zone, err := strconv.ParseUint(parts[0], 10, 8)
+		if err != nil {
+			return 0, fmt.Errorf("sccp: %q is not a valid %s point code: %w", s, v, err)
+		}
+		rest, err := strconv.ParseUint(parts[1], 10, 16)
+		if err != nil {
+			return 0, fmt.Errorf("sccp: %q is not a valid %s point code: %w", s, v, err)
+		}
+		return uint32(zone)<<11 | uint32(rest)&0x7ff, nil
+	default: // JAPAN16
+		return 0, fmt.Errorf("sccp: %q is not a valid %s point code", s, v)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2019-2023 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package params
+
+import "testing"
+
+func TestFormatParsePCRoundTrip(t *testing.T) {
+	cases := []struct {
+		v  Variant
+		pc uint32
+	}{
+		{ITU14, 0x1234 & 0x3fff},
+		{ANSI24, 0x010203},
+		{JAPAN16, 0x1234},
+		{CHINA24, 0xabcdef},
+	}
+	for _, c := range cases {
+		s := FormatPC(c.pc, c.v)
+		got, err := ParsePC(s, c.v)
+		if err != nil {
+			t.Errorf("ParsePC(FormatPC(%#x, %s)=%q, %s): %v", c.pc, c.v, s, c.v, err)
+			continue
+		}
+		if got != c.pc {
+			t.Errorf("ParsePC(FormatPC(%#x, %s)=%q, %s) = %#x, want %#x", c.pc, c.v, s, c.v, got, c.pc)
+		}
+	}
+}
+
+func TestParsePCInvalid(t *testing.T) {
+	if _, err := ParsePC("1-2-3-4", ANSI24); err == nil {
+		t.Error("ParsePC with too many parts for ANSI24 should return an error")
+	}
+	if _, err := ParsePC("not-a-number", ITU14); err == nil {
+		t.Error("ParsePC with non-numeric parts should return an error")
+	}
+}
+
+func TestMarshalUnmarshalANSI24(t *testing.T) {
+	p := NewPartyAddress(1, 0x010203, 8, 0, 0, 0, 0, nil)
+	p.Variant = ANSI24
+	p.SetLength()
+	b, err := p.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary(): %v", err)
+	}
+
+	got, err := ParsePartyAddressWithVariant(b, ANSI24)
+	if err != nil {
+		t.Fatalf("ParsePartyAddressWithVariant(): %v", err)
+	}
+	if got.SignalingPointCode != 0x010203 {
+		t.Errorf("SignalingPointCode = %#x, want %#x", got.SignalingPointCode, 0x010203)
+	}
+}
+
+func TestMarshalLenAccountsForVariant(t *testing.T) {
+	itu := NewPartyAddress(1, 1, 8, 0, 0, 0, 0, nil)
+	itu.Variant = ITU14
+
+	ansi := NewPartyAddress(1, 1, 8, 0, 0, 0, 0, nil)
+	ansi.Variant = ANSI24
+
+	if ansi.MarshalLen() != itu.MarshalLen()+1 {
+		t.Errorf("MarshalLen() ANSI24 = %d, ITU14 = %d, want ANSI24 exactly 1 octet longer", ansi.MarshalLen(), itu.MarshalLen())
+	}
+}